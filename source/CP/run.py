@@ -152,7 +152,7 @@ def run_model(model_file: str, solver_name: str, n: int, ss, sb: int, opt: bool)
         optimal_flag = True
 
     payload = {
-        "time": int(t),
+        "time": min(int(t), TIME_LIMIT),
         "optimal": optimal_flag,
         "obj": obj,
         "sol": sol,
@@ -0,0 +1,2 @@
+# Modules here import each other as common.<module>, so source/ must be on
+# sys.path. The inspection scripts are run from source/ as python -m common.<name>.
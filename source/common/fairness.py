@@ -0,0 +1,96 @@
+"""
+Fairness report for a STS solution (sol[period][week] = [home, away]).
+
+Per team:
+  home, away : number of home / away matches
+  imbalance  : |home - away| as written in sol
+  breaks     : consecutive weeks with the same home/away status
+  max_period : most matches played in a single period (must be <= 2)
+
+Run from source/: python -m common.fairness ../res/<approach>/<n>.json
+"""
+from common.schedule import iter_team
+from common.io_json import result_file_args, iter_result_entries
+
+
+def team_stats(sol, team):
+    home = away = breaks = 0
+    last = None
+    per_period = [0] * len(sol)
+
+    for _w, p, _opp, is_home in iter_team(sol, team):
+        if is_home:
+            home += 1
+        else:
+            away += 1
+        if is_home == last:
+            breaks += 1
+        last = is_home
+        per_period[p] += 1
+
+    return {
+        "home": home,
+        "away": away,
+        "imbalance": abs(home - away),
+        "breaks": breaks,
+        "max_period": max(per_period, default=0),
+    }
+
+
+def fairness_report(sol):
+    """
+    Returns {"teams": {team: stats}, "summary": {...}}.
+    summary.max_imbalance is measured on sol as written, so it matches obj
+    only when the approach writes the chosen home/away orientation.
+    """
+    if not sol:
+        return {"teams": {}, "summary": {}}
+
+    n = 2 * len(sol)
+    teams = {t: team_stats(sol, t) for t in range(1, n + 1)}
+    rows = teams.values()
+
+    return {
+        "teams": teams,
+        "summary": {
+            "max_imbalance": max(r["imbalance"] for r in rows),
+            "total_breaks": sum(r["breaks"] for r in rows),
+            "max_breaks": max(r["breaks"] for r in rows),
+            "max_period": max(r["max_period"] for r in rows),
+        },
+    }
+
+
+def report_markdown(report, obj=None):
+    """
+    Render a report as markdown. When the recorded obj is given it is shown
+    next to max_imbalance, with a warning if the two disagree (MIP and SMT do
+    not always write the chosen home/away orientation into sol).
+    """
+    cols = ["home", "away", "imbalance", "breaks", "max_period"]
+    lines = [
+        "| team | " + " | ".join(cols) + " |",
+        "| --- " * (len(cols) + 1) + "|",
+    ]
+    for t, r in report["teams"].items():
+        lines.append(f"| {t} | " + " | ".join(str(r[c]) for c in cols) + " |")
+    lines.append("")
+    for k, v in report["summary"].items():
+        lines.append(f"- {k}: {v}")
+    if obj is not None:
+        lines.append(f"- recorded obj: {obj}")
+        if obj != report["summary"]["max_imbalance"]:
+            lines.append("- WARNING: recorded obj differs from max_imbalance measured on sol")
+    return "\n".join(lines)
+
+
+if __name__ == "__main__":
+    args = result_file_args("Print a fairness report for each approach in a result JSON file.")
+
+    for approach, result in iter_result_entries(args.json_file, args.approach):
+        sol = result.get("sol") or []
+        print(f"## {approach}\n")
+        if not sol:
+            print("no solution\n")
+            continue
+        print(report_markdown(fairness_report(sol), result.get("obj")) + "\n")
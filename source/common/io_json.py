@@ -1,4 +1,5 @@
 import json
+import argparse
 from pathlib import Path
 
 def write_result_json(approach_name, json_path, solve_time, status, solution_matrix, obj=None):
@@ -41,3 +42,27 @@ def write_result_json(approach_name, json_path, solve_time, status, solution_mat
 
     with open(json_path, "w") as f:
         json.dump(data, f, indent=2)
+
+
+def read_result_json(json_path):
+    return json.loads(Path(json_path).read_text(encoding="utf-8"))
+
+
+def result_file_args(description):
+    """
+    Command line shared by the scripts that inspect one result file.
+    """
+    parser = argparse.ArgumentParser(description=description)
+    parser.add_argument("json_file", help="Path to a res/<approach>/<n>.json file")
+    parser.add_argument("--approach", type=str, default="", help="only use this approach key")
+    return parser.parse_args()
+
+
+def iter_result_entries(json_path, approach=""):
+    """
+    Yield (approach, entry) from a result file, optionally for one key only.
+    """
+    for name, entry in read_result_json(json_path).items():
+        if approach and name != approach:
+            continue
+        yield name, entry
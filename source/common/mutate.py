@@ -0,0 +1,130 @@
+"""
+Safe edits on a STS solution (sol[period][week] = [home, away]).
+
+Every operation leaves its input untouched and returns (new_sol, violations),
+where violations is common.violations.find_violations(new_sol) ([] when
+valid). Week or period indices outside the solution raise ValueError.
+"""
+from common.schedule import num_weeks
+from common.violations import find_violations
+
+
+def _copy(sol):
+    return [[list(m) for m in row] for row in sol]
+
+
+def _check_week(sol, w):
+    W = num_weeks(sol)
+    if not (isinstance(w, int) and not isinstance(w, bool) and 0 <= w < W):
+        raise ValueError(f"week {w} out of range 0..{W - 1}")
+
+
+def _check_period(sol, p):
+    if not (isinstance(p, int) and not isinstance(p, bool) and 0 <= p < len(sol)):
+        raise ValueError(f"period {p} out of range 0..{len(sol) - 1}")
+
+
+def _check_slot(sol, slot):
+    w, p = slot
+    _check_week(sol, w)
+    _check_period(sol, p)
+
+
+def _checked(sol):
+    return sol, find_violations(sol)
+
+
+def swap_fixtures(sol, a, b):
+    """
+    Exchange the matches in slots a and b, given as (week, period).
+    """
+    _check_slot(sol, a)
+    _check_slot(sol, b)
+    (wa, pa), (wb, pb) = a, b
+    out = _copy(sol)
+    out[pa][wa], out[pb][wb] = out[pb][wb], out[pa][wa]
+    return _checked(out)
+
+
+def swap_weeks(sol, w1, w2):
+    """
+    Exchange two whole weeks.
+    """
+    _check_week(sol, w1)
+    _check_week(sol, w2)
+    out = _copy(sol)
+    for row in out:
+        row[w1], row[w2] = row[w2], row[w1]
+    return _checked(out)
+
+
+def move_week(sol, w, to):
+    """
+    Shift week w to position `to`; the weeks in between move by one.
+    """
+    _check_week(sol, w)
+    _check_week(sol, to)
+    out = _copy(sol)
+    for row in out:
+        row.insert(to, row.pop(w))
+    return _checked(out)
+
+
+def flip_home(sol, week, period):
+    """
+    Reverse the home/away orientation of one match.
+    """
+    _check_slot(sol, (week, period))
+    out = _copy(sol)
+    out[period][week].reverse()
+    return _checked(out)
+
+
+def swap_periods(sol, p1, p2):
+    """
+    Exchange two whole periods (every week's match in p1 with the one in p2).
+    """
+    _check_period(sol, p1)
+    _check_period(sol, p2)
+    out = _copy(sol)
+    out[p1], out[p2] = out[p2], out[p1]
+    return _checked(out)
+
+
+def _changed(sol, new):
+    return sum(a != b for r1, r2 in zip(sol, new) for a, b in zip(r1, r2))
+
+
+def suggest_swaps(sol, slot):
+    """
+    Search for legal moves of the match in slot (week, period): flipping its
+    home side, swapping it with another match of the same week, and swapping
+    its whole week or whole period with another one. Single-slot swaps across
+    weeks are not tried, since both matches' teams already play in the other
+    week.
+
+    A move is kept only if it adds no violations versus sol, so on a valid
+    schedule every suggestion is valid too. Suggestions are ranked by fewest
+    violations, then fewest changed slots. An empty list means no legal swap.
+
+    Unlike the edits above this returns a list of {"move": (name, *args),
+    "sol": new_sol, "violations": [...], "changed": int}.
+    """
+    _check_slot(sol, slot)
+    w, p = slot
+    base = len(_checked(sol)[1])
+
+    moves = [("flip_home", w, p)]
+    moves += [("swap_fixtures", (w, p), (w, q)) for q in range(len(sol)) if q != p]
+    moves += [("swap_weeks", w, w2) for w2 in range(len(sol[0])) if w2 != w]
+    moves += [("swap_periods", p, q) for q in range(len(sol)) if q != p]
+
+    out = []
+    for move in moves:
+        new, found = globals()[move[0]](sol, *move[1:])
+        if len(found) > base:
+            continue
+        out.append({"move": move, "sol": new, "violations": found, "changed": _changed(sol, new)})
+
+    out.sort(key=lambda s: (len(s["violations"]), s["changed"], s["move"][0]))
+    return out
@@ -0,0 +1,99 @@
+"""
+Helpers over the checker schedule format:
+
+  sol[period][week] = [home, away]
+
+Weeks and periods are 0-based indices into sol, teams are 1..n.
+"""
+
+
+def num_weeks(sol):
+    return len(sol[0]) if sol else 0
+
+
+def iter_week(sol, w):
+    """
+    Yield (period, home, away) for every match of week w.
+    """
+    for p, row in enumerate(sol):
+        home, away = row[w]
+        yield p, home, away
+
+
+def iter_fixtures(sol):
+    """
+    Yield (week, period, home, away) week by week, without building
+    an intermediate list.
+    """
+    for w in range(num_weeks(sol)):
+        for p, home, away in iter_week(sol, w):
+            yield w, p, home, away
+
+
+def iter_team(sol, team):
+    """
+    Yield (week, period, opponent, is_home) for the matches of one team.
+    """
+    for w, p, home, away in iter_fixtures(sol):
+        if home == team:
+            yield w, p, away, True
+        elif away == team:
+            yield w, p, home, False
+
+
+def _by_pair(sol):
+    # a list per pair, so duplicates in hand-edited schedules are all kept
+    out = {}
+    for w, p, h, a in iter_fixtures(sol):
+        out.setdefault(frozenset((h, a)), []).append((w, p, h, a))
+    return out
+
+
+def diff(old, new):
+    """
+    Compare two schedules fixture by fixture (a fixture is identified by
+    its unordered pair of teams). Fixtures are (week, period, home, away).
+    If a pair occurs more than once, unchanged occurrences are matched
+    first and the rest are paired in week order; extra ones are added or
+    removed.
+
+    Returns a dict with:
+      added   : fixtures only in new
+      removed : fixtures only in old
+      moved   : (old, new) where week, period or home side changed
+      swapped : ((old_a, new_a), (old_b, new_b)) for two fixtures that
+                exchanged their (week, period) slots; a home/away change
+                shows as new_x differing from old_x in its home side.
+                These are not repeated in moved
+    """
+    before = _by_pair(old)
+    after = _by_pair(new)
+
+    added, removed, moved = [], [], []
+    for pair in {**before, **after}:
+        olds = [f for f in before.get(pair, []) if f not in after.get(pair, [])]
+        news = [f for f in after.get(pair, []) if f not in before.get(pair, [])]
+        moved += zip(olds, news)
+        removed += olds[len(news):]
+        added += news[len(olds):]
+
+    # slot -> index into moved, for fixtures whose slot changed
+    by_old_slot = {o[:2]: i for i, (o, nw) in enumerate(moved) if o[:2] != nw[:2]}
+
+    swapped = []
+    paired = set()
+    for i, (o, nw) in enumerate(moved):
+        j = by_old_slot.get(nw[:2])
+        if j is None or i in paired or j in paired or j == i:
+            continue
+        o2, nw2 = moved[j]
+        if nw2[:2] == o[:2]:
+            swapped.append(((o, nw), (o2, nw2)))
+            paired.update((i, j))
+
+    return {
+        "added": added,
+        "removed": removed,
+        "moved": [m for i, m in enumerate(moved) if i not in paired],
+        "swapped": swapped,
+    }
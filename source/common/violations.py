@@ -0,0 +1,100 @@
+"""
+Conflict detection over any STS solution (sol[period][week] = [home, away]),
+including hand-edited ones.
+
+Unlike solution_checker.check_solution, which stops at the first message per
+rule, this lists every violation with the entities involved:
+
+  {"constraint": str, "severity": "fatal" | "error", "entities": {...}}
+
+"fatal" means the matrix shape is wrong and the other rules were skipped.
+Slots in entities are (week, period), 0-based like the sol indices.
+
+Run from source/: python -m common.violations ../res/<approach>/<n>.json
+"""
+from collections import defaultdict
+
+from common.schedule import iter_fixtures
+from common.io_json import result_file_args, iter_result_entries
+
+
+def _violation(constraint, severity, **entities):
+    return {"constraint": constraint, "severity": severity, "entities": entities}
+
+
+def shape_violations(sol):
+    if not isinstance(sol, list) or not sol:
+        return [_violation("shape", "fatal", reason="solution must be a non-empty list")]
+
+    P = len(sol)
+    W = 2 * P - 1
+    out = []
+
+    for p, row in enumerate(sol):
+        if not isinstance(row, list) or len(row) != W:
+            out.append(_violation("shape", "fatal", period=p, expected_weeks=W))
+            continue
+        for w, m in enumerate(row):
+            if not (isinstance(m, list) and len(m) == 2 and all(isinstance(t, int) and not isinstance(t, bool) for t in m)):
+                out.append(_violation("shape", "fatal", slot=(w, p), match=m))
+
+    return out
+
+
+def find_violations(sol):
+    """
+    Return every violation in sol; [] means the solution is valid.
+    """
+    out = shape_violations(sol)
+    if out:
+        return out
+
+    n = 2 * len(sol)
+    slots_by_pair = defaultdict(list)
+    week_slots = defaultdict(list)
+    period_slots = defaultdict(list)
+
+    for w, p, home, away in iter_fixtures(sol):
+        for t in (home, away):
+            if not 1 <= t <= n:
+                out.append(_violation("team_range", "error", team=t, slot=(w, p), n=n))
+        if home == away:
+            out.append(_violation("self_play", "error", team=home, slot=(w, p)))
+            continue
+
+        slots_by_pair[frozenset((home, away))].append((w, p))
+        for t in (home, away):
+            week_slots[(t, w)].append((w, p))
+            period_slots[(t, p)].append((w, p))
+
+    for pair, slots in slots_by_pair.items():
+        if len(slots) > 1:
+            out.append(_violation("pair_once", "error", teams=tuple(sorted(pair)), slots=slots))
+
+    for a in range(1, n + 1):
+        for b in range(a + 1, n + 1):
+            if frozenset((a, b)) not in slots_by_pair:
+                out.append(_violation("pair_missing", "error", teams=(a, b)))
+
+    for (t, w), slots in sorted(week_slots.items()):
+        if len(slots) > 1:
+            out.append(_violation("once_per_week", "error", team=t, week=w, slots=slots))
+
+    for (t, p), slots in sorted(period_slots.items()):
+        if len(slots) > 2:
+            out.append(_violation("period_limit", "error", team=t, period=p, slots=slots))
+
+    return out
+
+
+if __name__ == "__main__":
+    args = result_file_args("List every constraint violation in a result JSON file.")
+
+    for approach, result in iter_result_entries(args.json_file, args.approach):
+        sol = result.get("sol") or []
+        if not sol:
+            continue
+        found = find_violations(sol)
+        print(f"{approach}: {'valid' if not found else f'{len(found)} violation(s)'}")
+        for v in found:
+            print(f"  [{v['severity']}] {v['constraint']} {v['entities']}")